@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"errors"
+	"strings"
+
+	hashivault "github.com/hashicorp/vault/api"
+)
+
+type Kubernetes struct {
+	client *hashivault.Client
+}
+
+type KubernetesLoginOptions struct {
+	Role      string
+	JWT       string
+	MountPath string
+}
+
+func (k *Kubernetes) Login(options KubernetesLoginOptions) (*hashivault.Secret, error) {
+	authSecret, err := k.kubernetesLogin(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if authSecret.Auth == nil {
+		return nil, errors.New("Vault Kubernetes Auth returned nil")
+	}
+
+	k.client.SetToken(authSecret.Auth.ClientToken)
+	return authSecret, nil
+}
+
+func (k *Kubernetes) kubernetesLogin(options KubernetesLoginOptions) (*hashivault.Secret, error) {
+	kubernetesCreds := map[string]interface{}{
+		"role": options.Role,
+		"jwt":  options.JWT,
+	}
+
+	authPath := "auth/kubernetes/login"
+	if options.MountPath != "" {
+		authPath = "auth/" + strings.Trim(options.MountPath, "/") + "/login"
+	}
+
+	authSecret, err := k.client.Logical().Write(authPath, kubernetesCreds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if authSecret == nil {
+		return nil, errors.New("empty response from Vault Kubernetes")
+	}
+
+	return authSecret, nil
+}
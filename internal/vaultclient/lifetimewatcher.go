@@ -0,0 +1,71 @@
+package vault
+
+import hashivault "github.com/hashicorp/vault/api"
+
+// LifetimeWatcherInput configures a LifetimeWatcher
+type LifetimeWatcherInput struct {
+	// Secret is the secret to renew, typically the result of Token.Login or Token.LookupSelf
+	Secret *hashivault.Secret
+
+	// Increment is the renewal TTL, in seconds, requested on each renewal. Vault may return a shorter lease.
+	Increment int
+}
+
+// LifetimeWatcher renews a Vault auth token's lease in the background, and reports on DoneCh when renewal ends
+// (the token expired, hit its max TTL, or renewal was not permitted)
+type LifetimeWatcher struct {
+	renewer *hashivault.Renewer
+}
+
+// NewLifetimeWatcher creates a LifetimeWatcher for the token described by input.Secret
+func (c *Client) NewLifetimeWatcher(input *LifetimeWatcherInput) (*LifetimeWatcher, error) {
+	secret := input.Secret
+	if secret != nil && secret.Auth == nil {
+		// A token lookup response carries the token in Data; renew it as an auth token rather than as a lease
+		token, err := secret.TokenID()
+		if err != nil {
+			return nil, err
+		}
+		renewable, err := secret.TokenIsRenewable()
+		if err != nil {
+			return nil, err
+		}
+		ttl, err := secret.TokenTTL()
+		if err != nil {
+			return nil, err
+		}
+		secret = &hashivault.Secret{Auth: &hashivault.SecretAuth{ClientToken: token, Renewable: renewable, LeaseDuration: int(ttl.Seconds())}}
+	}
+
+	renewer, err := c.client.NewRenewer(&hashivault.RenewerInput{
+		Secret:    secret,
+		Increment: input.Increment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LifetimeWatcher{renewer: renewer}, nil
+}
+
+// Start runs the renewal loop. Start blocks until Stop is called or renewal ends, so callers should run it in a
+// goroutine
+func (w *LifetimeWatcher) Start() {
+	w.renewer.Renew()
+}
+
+// Stop ends the renewal loop started by Start
+func (w *LifetimeWatcher) Stop() {
+	w.renewer.Stop()
+}
+
+// RenewCh returns a channel that receives a value after each successful renewal
+func (w *LifetimeWatcher) RenewCh() <-chan *hashivault.RenewOutput {
+	return w.renewer.RenewCh()
+}
+
+// DoneCh returns a channel that receives a value when renewal ends, nil on a clean stop or an error describing why
+// renewal could not continue
+func (w *LifetimeWatcher) DoneCh() <-chan error {
+	return w.renewer.DoneCh()
+}
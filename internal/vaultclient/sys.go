@@ -0,0 +1,12 @@
+package vault
+
+import hashivault "github.com/hashicorp/vault/api"
+
+type Sys struct {
+	client *hashivault.Client
+}
+
+// Unwrap reads the response stored at a response-wrapping token and returns the secret it wrapped
+func (s *Sys) Unwrap(wrappingToken string) (*hashivault.Secret, error) {
+	return s.client.Logical().Unwrap(wrappingToken)
+}
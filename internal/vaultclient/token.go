@@ -0,0 +1,27 @@
+package vault
+
+import (
+	hashivault "github.com/hashicorp/vault/api"
+)
+
+type Token struct {
+	client *hashivault.Client
+}
+
+type TokenOptions struct {
+	Token string
+}
+
+func (a *Token) Login(options TokenOptions) (*hashivault.Secret, error) {
+	a.client.SetToken(options.Token)
+	return a.client.Auth().Token().LookupSelf()
+}
+
+func (a *Token) Renew(increment int) (*hashivault.Secret, error) {
+	return a.client.Auth().Token().RenewSelf(increment)
+}
+
+// LookupSelf returns the Secret describing the client's currently set token, for use as LifetimeWatcher input
+func (a *Token) LookupSelf() (*hashivault.Secret, error) {
+	return a.client.Auth().Token().LookupSelf()
+}
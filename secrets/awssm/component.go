@@ -0,0 +1,78 @@
+// Package awssm provides the AWS Secrets Manager implementation for the SecretGetter interface
+package awssm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	"github.com/lincolnto/secrets_vault_sample/secrets"
+)
+
+// Config configures the AWS Secrets Manager Component
+type Config struct {
+	// SecretID is the ARN or friendly name of the AWS Secrets Manager secret to fetch
+	SecretID string
+	// Region overrides the AWS SDK's default region resolution, if set
+	Region string
+}
+
+// Component provides a SecretGetter backed by AWS Secrets Manager. The secret identified by Config.SecretID is
+// expected to hold a JSON object whose keys are the individual secret keys requested via GetSecret.
+type Component struct {
+	config *Config
+	client *secretsmanager.Client
+}
+
+// NewComponent initializes a Component, loading AWS credentials and region via the default SDK credential chain
+func NewComponent(ctx context.Context, config *Config) (*Component, error) {
+	var optFns []func(*awsconfig.LoadOptions) error
+	if config.Region != "" {
+		optFns = append(optFns, awsconfig.WithRegion(config.Region))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config for Secrets Manager, err: %w", err)
+	}
+
+	return &Component{
+		config: config,
+		client: secretsmanager.NewFromConfig(awsCfg),
+	}, nil
+}
+
+// GetSecret fetches key from the JSON object stored in Config.SecretID
+func (c *Component) GetSecret(key string) (secret string, err error) {
+	secretResp, err := c.client.GetSecretValue(context.Background(), &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(c.config.SecretID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch secret %q from AWS Secrets Manager: %w", c.config.SecretID, err)
+	}
+
+	var values map[string]string
+	if unmarshalErr := json.Unmarshal([]byte(aws.ToString(secretResp.SecretString)), &values); unmarshalErr != nil {
+		return "", fmt.Errorf("failed to parse AWS Secrets Manager secret %q as a JSON object: %w", c.config.SecretID, unmarshalErr)
+	}
+
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in AWS Secrets Manager secret %q", key, c.config.SecretID)
+	}
+
+	return value, nil
+}
+
+func init() {
+	secrets.Register("awssm", func(config map[string]string) (secrets.SecretGetter, error) {
+		return NewComponent(context.Background(), &Config{
+			SecretID: config["secretID"],
+			Region:   config["region"],
+		})
+	})
+}
@@ -0,0 +1,32 @@
+package secrets
+
+import "errors"
+
+// chainGetter tries each wrapped SecretGetter in order, returning the first hit
+type chainGetter struct {
+	getters []SecretGetter
+}
+
+// Chain returns a SecretGetter that tries each of getters in turn, returning the result of the first one that
+// succeeds. If every backend fails, Chain returns the combined error from all of them.
+func Chain(getters ...SecretGetter) SecretGetter {
+	return &chainGetter{getters: getters}
+}
+
+// GetSecret implements SecretGetter
+func (c *chainGetter) GetSecret(key string) (secret string, err error) {
+	if len(c.getters) == 0 {
+		return "", errors.New("secrets: Chain has no backends configured")
+	}
+
+	var errs []error
+	for _, getter := range c.getters {
+		secret, err = getter.GetSecret(key)
+		if err == nil {
+			return secret, nil
+		}
+		errs = append(errs, err)
+	}
+
+	return "", errors.Join(errs...)
+}
@@ -0,0 +1,61 @@
+// Package gcpsm provides the GCP Secret Manager implementation for the SecretGetter interface
+package gcpsm
+
+import (
+	"context"
+	"fmt"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+
+	"github.com/lincolnto/secrets_vault_sample/secrets"
+)
+
+// Config configures the GCP Secret Manager Component
+type Config struct {
+	// ProjectID is the GCP project containing the secrets
+	ProjectID string
+}
+
+// Component provides a SecretGetter backed by GCP Secret Manager. Each requested key maps to its own secret,
+// fetched as projects/{ProjectID}/secrets/{key}/versions/latest.
+type Component struct {
+	config *Config
+	client *secretmanager.Client
+}
+
+// NewComponent initializes a Component, authenticating the GCP Secret Manager client via application default
+// credentials
+func NewComponent(ctx context.Context, config *Config) (*Component, error) {
+	client, err := secretmanager.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCP Secret Manager client: %w", err)
+	}
+
+	return &Component{
+		config: config,
+		client: client,
+	}, nil
+}
+
+// GetSecret fetches the latest version of the GCP Secret Manager secret named key
+func (c *Component) GetSecret(key string) (secret string, err error) {
+	name := fmt.Sprintf("projects/%s/secrets/%s/versions/latest", c.config.ProjectID, key)
+
+	secretResp, err := c.client.AccessSecretVersion(context.Background(), &secretmanagerpb.AccessSecretVersionRequest{
+		Name: name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to access GCP Secret Manager secret %q: %w", name, err)
+	}
+
+	return string(secretResp.Payload.Data), nil
+}
+
+func init() {
+	secrets.Register("gcpsm", func(config map[string]string) (secrets.SecretGetter, error) {
+		return NewComponent(context.Background(), &Config{
+			ProjectID: config["projectID"],
+		})
+	})
+}
@@ -0,0 +1,62 @@
+// Package local provides an in-memory/environment-backed implementation of the SecretGetter interface, for use in
+// tests and local development where no live secrets backend is available
+package local
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lincolnto/secrets_vault_sample/secrets"
+)
+
+// Config configures the local Component
+type Config struct {
+	// Values holds key/value pairs served directly by GetSecret
+	Values map[string]string
+	// EnvPrefix, if set, causes GetSecret to also check the environment variable EnvPrefix+strings.ToUpper(key)
+	// whenever key is not found in Values
+	EnvPrefix string
+}
+
+// Component provides a SecretGetter backed by an in-memory map and, optionally, environment variables
+type Component struct {
+	config *Config
+}
+
+// NewComponent initializes a Component from the given Config
+func NewComponent(config *Config) *Component {
+	return &Component{config: config}
+}
+
+// GetSecret fetches key from Config.Values, falling back to the environment variable
+// Config.EnvPrefix+strings.ToUpper(key) if EnvPrefix is set
+func (c *Component) GetSecret(key string) (secret string, err error) {
+	if value, ok := c.config.Values[key]; ok {
+		return value, nil
+	}
+
+	if c.config.EnvPrefix != "" {
+		if value, ok := os.LookupEnv(c.config.EnvPrefix + strings.ToUpper(key)); ok {
+			return value, nil
+		}
+	}
+
+	return "", fmt.Errorf("local: key %q not found", key)
+}
+
+func init() {
+	secrets.Register("local", func(config map[string]string) (secrets.SecretGetter, error) {
+		values := make(map[string]string, len(config))
+		for key, value := range config {
+			if key != "envPrefix" {
+				values[key] = value
+			}
+		}
+
+		return NewComponent(&Config{
+			Values:    values,
+			EnvPrefix: config["envPrefix"],
+		}), nil
+	})
+}
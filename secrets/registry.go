@@ -0,0 +1,36 @@
+package secrets
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Factory constructs a SecretGetter from a flat string configuration map. Backend packages register a Factory
+// under a name (typically from an init function), and callers use New to build a SecretGetter by name at runtime
+// without importing the backend package directly.
+type Factory func(config map[string]string) (SecretGetter, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+// Register adds a backend Factory under name. Calling Register twice with the same name overwrites the prior
+// Factory.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = factory
+}
+
+// New constructs a SecretGetter using the Factory registered under name
+func New(name string, config map[string]string) (SecretGetter, error) {
+	registryMu.RLock()
+	factory, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("secrets: no backend registered under name %q", name)
+	}
+
+	return factory(config)
+}
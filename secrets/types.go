@@ -1,7 +1,8 @@
 package secrets
 
 // SecretGetter is a common interface provided for interaction with Secrets Management stores
-// Refer to subpackages in this directory for service-specific implementations
+// Refer to subpackages in this directory for service-specific implementations (vault, awssm, gcpsm, local), or use
+// Chain to fall back across several, or Register/New to select one by name from configuration
 type SecretGetter interface {
 	GetSecret(key string) (secret string, err error)
 }
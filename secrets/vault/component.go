@@ -2,7 +2,11 @@
 package vault
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/riotgames/vault-go-client"
 )
@@ -10,18 +14,52 @@ import (
 // Misc Consts
 const (
 	emptyStr = ""
+
+	// latestVersion is the sentinel passed internally to request the newest version of a secret
+	latestVersion = 0
 )
 
 // Vault Consts
 const (
 	defaultURL = "https://your.vault.server.url/"
+
+	// defaultRenewIncrement is the renewal increment, in seconds, requested on each background token renewal
+	defaultRenewIncrement = 3600
+
+	// defaultCacheTTL is how long a secret's data and metadata are cached before being re-fetched from Vault
+	defaultCacheTTL = 1 * time.Minute
 )
 
 // Component provides a lightweight wrapper around the public riotgames/vault-go-client package for integration with
 // internally hosted Vault offerings
 type Component struct {
-	config      *Config
+	config *Config
+	opts   *options
+
+	mu          sync.RWMutex
 	vaultClient *vault.Client
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+
+	cacheMu sync.Mutex
+	cache   map[secretCacheKey]*cachedSecret
+}
+
+// secretCacheKey identifies a single cached KV v2 read by mount path, secret path, and version
+// (latestVersion for the newest version)
+type secretCacheKey struct {
+	mountPath  string
+	secretPath string
+	version    int
+}
+
+// cachedSecret holds the parsed data and metadata from a single KV2.Get call
+type cachedSecret struct {
+	data      map[string]interface{}
+	metadata  map[string]interface{}
+	fetchedAt time.Time
 }
 
 // NewComponent provides a method to initialize a base Component struct.
@@ -46,32 +84,265 @@ func NewComponent(config *Config, options ...Option) *Component {
 		opts.log.Fatal(validateErr.Error())
 	}
 
+	vaultClient, err := newAuthenticatedClient(opts)
+	if err != nil {
+		opts.log.Fatal(err.Error())
+	}
+
+	return &Component{
+		config:      config,
+		opts:        opts,
+		vaultClient: vaultClient,
+		cache:       make(map[secretCacheKey]*cachedSecret),
+	}
+}
+
+// newAuthenticatedClient builds a Vault client pointed at opts.url and authenticates it via opts.authProvider
+func newAuthenticatedClient(opts *options) (*vault.Client, error) {
 	vaultClientConfig := vault.DefaultConfig()
 	vaultClientConfig.Address = opts.url
 	vaultClient, err := vault.NewClient(vaultClientConfig)
 	if err != nil {
-		opts.log.Fatal(fmt.Sprintf("failed to initialize Vault client, err: %s", err.Error()))
+		return nil, fmt.Errorf("failed to initialize Vault client, err: %w", err)
 	}
 	if authErr := opts.authProvider(vaultClient); authErr != nil {
-		opts.log.Fatal(fmt.Sprintf("failed to authenticate Vault client, err: %s", authErr.Error()))
+		return nil, fmt.Errorf("failed to authenticate Vault client, err: %w", authErr)
 	}
 
-	return &Component{
-		config:      config,
-		vaultClient: vaultClient,
+	return vaultClient, nil
+}
+
+// Start launches a background goroutine that renews the Vault Client's auth token using Vault's LifetimeWatcher,
+// re-authenticating with the configured AuthProvider whenever renewal ends (token expired, hit its max TTL, or
+// renewal not permitted). Start returns once the first watcher is established; call Stop to end renewal.
+func (c *Component) Start(ctx context.Context) error {
+	watcher, err := c.newLifetimeWatcher()
+	if err != nil {
+		return err
+	}
+
+	c.stopCh = make(chan struct{})
+	c.wg.Add(1)
+	go c.watch(ctx, watcher)
+
+	return nil
+}
+
+// Stop ends background token renewal started by Start and waits for the watcher goroutine to exit. Stop is safe
+// to call more than once, including concurrently.
+func (c *Component) Stop() {
+	if c.stopCh == nil {
+		return
+	}
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+	c.wg.Wait()
+}
+
+// newLifetimeWatcher creates a LifetimeWatcher for the current Vault Client's auth token
+func (c *Component) newLifetimeWatcher() (*vault.LifetimeWatcher, error) {
+	client := c.client()
+
+	tokenSecret, err := client.Auth.Token.LookupSelf()
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up Vault Client token for renewal: %w", err)
+	}
+
+	watcher, err := client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{
+		Secret:    tokenSecret,
+		Increment: c.opts.renewIncrement,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Vault Client token lifetime watcher: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// watch runs the renew/re-auth loop until ctx is done or Stop is called
+func (c *Component) watch(ctx context.Context, watcher *vault.LifetimeWatcher) {
+	defer c.wg.Done()
+
+	go watcher.Start()
+	defer func() { watcher.Stop() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.stopCh:
+			return
+		case renewal := <-watcher.RenewCh():
+			c.opts.log.Debug(fmt.Sprintf("Vault Client token renewed, lease duration: %ds", renewal.Secret.Auth.LeaseDuration))
+		case renewErr := <-watcher.DoneCh():
+			if renewErr != nil {
+				c.opts.log.Info(fmt.Sprintf("Vault Client token renewal ended, err: %s; re-authenticating", renewErr.Error()))
+				if c.opts.onRenewalError != nil {
+					c.opts.onRenewalError(renewErr)
+				}
+			}
+
+			nextWatcher, reauthErr := c.reauthenticate()
+			if reauthErr != nil {
+				c.opts.log.Error(fmt.Sprintf("failed to re-authenticate Vault Client, stopping renewal, err: %s", reauthErr.Error()))
+				return
+			}
+
+			watcher = nextWatcher
+			go watcher.Start()
+		}
 	}
 }
 
-// GetSecret fetches a secret from the Vault server
+// reauthenticate re-runs the configured AuthProvider against a fresh Vault client, swaps it in for GetSecret callers,
+// and returns a LifetimeWatcher for the new token
+func (c *Component) reauthenticate() (*vault.LifetimeWatcher, error) {
+	vaultClient, err := newAuthenticatedClient(c.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.vaultClient = vaultClient
+	c.mu.Unlock()
+
+	return c.newLifetimeWatcher()
+}
+
+// client returns the current Vault client, safe for concurrent use alongside background re-authentication
+func (c *Component) client() *vault.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vaultClient
+}
+
+// GetSecret fetches the named key from the newest version of the Vault secret
 func (c *Component) GetSecret(key string) (secret string, err error) {
-	secretResp, err := c.vaultClient.KV2.Get(vault.KV2GetOptions{
+	return c.GetSecretVersion(key, latestVersion)
+}
+
+// GetSecretVersion fetches the named key from a specific version of the Vault secret. Pass 0 for the newest version.
+func (c *Component) GetSecretVersion(key string, version int) (secret string, err error) {
+	cached, err := c.fetchSecret(version)
+	if err != nil {
+		return emptyStr, err
+	}
+
+	return extractSecretKey(cached.data, key)
+}
+
+// GetSecrets fetches multiple keys from the newest version of the Vault secret in a single read
+func (c *Component) GetSecrets(keys ...string) (map[string]string, error) {
+	cached, err := c.fetchSecret(latestVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value, err := extractSecretKey(cached.data, key)
+		if err != nil {
+			return nil, err
+		}
+		secrets[key] = value
+	}
+
+	return secrets, nil
+}
+
+// fetchSecret returns the cached data/metadata for (SecretMountPath, SecretPath, version), re-reading from Vault via
+// a single KV2.Get when there is no entry or the cached entry has exceeded the configured cache TTL
+func (c *Component) fetchSecret(version int) (*cachedSecret, error) {
+	cacheKey := secretCacheKey{
+		mountPath:  c.config.SecretMountPath,
+		secretPath: c.config.SecretPath,
+		version:    version,
+	}
+
+	c.cacheMu.Lock()
+	cached, ok := c.cache[cacheKey]
+	c.cacheMu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < c.opts.cacheTTL {
+		return cached, nil
+	}
+
+	getOptions := vault.KV2GetOptions{
 		MountPath:  c.config.SecretMountPath,
 		SecretPath: c.config.SecretPath,
-	})
+	}
+	if version != latestVersion {
+		getOptions.Version = version
+	}
+
+	secretResp, err := c.client().KV2.Get(getOptions)
 	if err != nil {
-		return emptyStr, err
+		return nil, err
+	}
+
+	data, ok := secretResp.Data["data"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: secret response is missing its \"data\" field", ErrSecretShape)
+	}
+	metadata, _ := secretResp.Data["metadata"].(map[string]interface{})
+
+	cached = &cachedSecret{
+		data:      data,
+		metadata:  metadata,
+		fetchedAt: time.Now(),
+	}
+
+	// metadata.version is Vault's record of which version this response actually is. When the caller asked for a
+	// specific version, make sure Vault gave us that one back; when the caller asked for the newest version, cache
+	// the response under its resolved version too so a later explicit request for that same version is a cache hit.
+	resolvedVersion, hasResolvedVersion := versionFromMetadata(metadata)
+	if hasResolvedVersion && version != latestVersion && resolvedVersion != version {
+		return nil, fmt.Errorf("%w: requested version %d, Vault returned version %d", ErrSecretShape, version, resolvedVersion)
+	}
+
+	c.cacheMu.Lock()
+	c.cache[cacheKey] = cached
+	if hasResolvedVersion && version == latestVersion {
+		resolvedKey := cacheKey
+		resolvedKey.version = resolvedVersion
+		c.cache[resolvedKey] = cached
+	}
+	c.cacheMu.Unlock()
+
+	return cached, nil
+}
+
+// versionFromMetadata reads the numeric "version" field out of a KV v2 response's metadata map. It reports false
+// if metadata has no version field or the field isn't a recognized numeric type.
+func versionFromMetadata(metadata map[string]interface{}) (int, bool) {
+	switch v := metadata["version"].(type) {
+	case float64:
+		return int(v), true
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, false
+		}
+		return int(n), true
+	case int:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// extractSecretKey reads key out of a secret's data map, returning ErrKeyNotFound or ErrSecretShape instead of
+// panicking on a missing key or unexpected value type
+func extractSecretKey(data map[string]interface{}, key string) (string, error) {
+	raw, ok := data[key]
+	if !ok {
+		return emptyStr, fmt.Errorf("%w: %q", ErrKeyNotFound, key)
+	}
+
+	value, ok := raw.(string)
+	if !ok {
+		return emptyStr, fmt.Errorf("%w: key %q is not a string", ErrSecretShape, key)
 	}
 
-	secretMap := secretResp.Data["data"].(map[string]interface{})
-	return secretMap[key].(string), nil
+	return value, nil
 }
@@ -2,6 +2,9 @@ package vault
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/riotgames/vault-go-client"
 )
@@ -13,18 +16,32 @@ const (
 	envSecretPath = "SECRET_PATH"
 
 	// Auth-related configuration
-	envAWSIAMRoleARN   = "IAM_ROLE_ARN"
-	envAppRoleID       = "APP_ROLE_ID"
-	envAppRoleSecretID = "APP_ROLE_SECRET_ID"
+	envAWSIAMRoleARN          = "IAM_ROLE_ARN"
+	envAppRoleID              = "APP_ROLE_ID"
+	envAppRoleSecretID        = "APP_ROLE_SECRET_ID"
+	envAppRoleSecretIDFile    = "APP_ROLE_SECRET_ID_FILE"
+	envAppRoleSecretIDEnv     = "APP_ROLE_SECRET_ID_ENV"
+	envAppRoleIsWrappingToken = "APP_ROLE_IS_WRAPPING_TOKEN"
+	envK8sRole                = "K8S_ROLE"
+	envK8sJWTPath             = "K8S_JWT_PATH"
+
+	// defaultK8sJWTPath is the path Kubernetes projects a pod's ServiceAccount token to by default
+	defaultK8sJWTPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 
 	// Vault Space Path Formats
-	secretsMountPathFormat = "%s/secrets"
-	awsLoginPathFormat     = "%s/aws"
-	appRoleLoginPathFormat = "%s/approle"
+	secretsMountPathFormat    = "%s/secrets"
+	awsLoginPathFormat        = "%s/aws"
+	appRoleLoginPathFormat    = "%s/approle"
+	kubernetesLoginPathFormat = "%s/kubernetes"
 )
 
 const (
-	errFmtConfigValidation = "failed to validate Vault Provider config, %s is empty"
+	errFmtConfigValidation        = "failed to validate Vault Provider config, %s is empty"
+	errFmtAppRoleSecretIDConflict = "failed to validate Vault Provider config, only one of %s, %s, %s may be set"
+
+	// Property names used in Validate's error messages
+	propertySpaceName  = "SpaceName"
+	propertySecretPath = "SecretPath"
 )
 
 // Config fetches relevant component configuration values from the Glue property registry
@@ -43,8 +60,24 @@ type Config struct {
 	AWSIAMRoleArn string
 	// The Vault AppRole ID used Vault Auth
 	AppRoleID string
-	// The Vault AppRole Secret ID used for Vault Auth
+	// The Vault AppRole Secret ID used for Vault Auth, supplied inline. Mutually exclusive with AppRoleSecretIDFile
+	// and AppRoleSecretIDEnv
 	AppRoleSecretID string
+	// A file path to read the Vault AppRole Secret ID from, lazily at login time. Mutually exclusive with
+	// AppRoleSecretID and AppRoleSecretIDEnv
+	AppRoleSecretIDFile string
+	// The name of an environment variable to read the Vault AppRole Secret ID from, indirectly at login time.
+	// Mutually exclusive with AppRoleSecretID and AppRoleSecretIDFile
+	AppRoleSecretIDEnv string
+	// IsWrappingToken indicates that the resolved AppRole Secret ID value is a response-wrapping token that must be
+	// unwrapped to obtain the real Secret ID
+	IsWrappingToken bool
+	// The Vault Login Path for Vault Kubernetes Auth (e.g. "MyTeamVaultSpace/kubernetes")
+	KubernetesLoginPath string
+	// The Vault Kubernetes Auth role bound to the pod's ServiceAccount
+	KubernetesRole string
+	// The path to the pod's projected ServiceAccount JWT, used for Vault Kubernetes Auth
+	KubernetesJWTPath string
 }
 
 // NewConfig returns a config object, with getters for config values relevant to the Vault Provider
@@ -59,12 +92,33 @@ func NewConfig() *Config {
 		AWSLoginPath:     fmt.Sprintf(awsLoginPathFormat, spaceName),
 		AppRoleLoginPath: fmt.Sprintf(appRoleLoginPathFormat, spaceName),
 
-		AWSIAMRoleArn:   os.Getenv(envAWSIAMRoleARN),
-		AppRoleID:       os.Getenv(envAppRoleID),
-		AppRoleSecretID: os.Getenv(envAppRoleSecretID),
+		AWSIAMRoleArn:       os.Getenv(envAWSIAMRoleARN),
+		AppRoleID:           os.Getenv(envAppRoleID),
+		AppRoleSecretID:     os.Getenv(envAppRoleSecretID),
+		AppRoleSecretIDFile: os.Getenv(envAppRoleSecretIDFile),
+		AppRoleSecretIDEnv:  os.Getenv(envAppRoleSecretIDEnv),
+		IsWrappingToken:     isWrappingToken(),
+
+		KubernetesLoginPath: fmt.Sprintf(kubernetesLoginPathFormat, spaceName),
+		KubernetesRole:      os.Getenv(envK8sRole),
+		KubernetesJWTPath:   getEnvOrDefault(envK8sJWTPath, defaultK8sJWTPath),
 	}
 }
 
+// getEnvOrDefault returns the value of the named environment variable, or fallback if it is unset
+func getEnvOrDefault(name, fallback string) string {
+	if value := os.Getenv(name); value != emptyStr {
+		return value
+	}
+	return fallback
+}
+
+// isWrappingToken parses envAppRoleIsWrappingToken, defaulting to false if unset or unparseable
+func isWrappingToken() bool {
+	wrapping, _ := strconv.ParseBool(os.Getenv(envAppRoleIsWrappingToken))
+	return wrapping
+}
+
 // getIAMLoginOptions creates an IAMLoginOptions object with values from the Component config
 func (c *Config) getIAMLoginOptions() vault.IAMLoginOptions {
 	return vault.IAMLoginOptions{
@@ -73,13 +127,69 @@ func (c *Config) getIAMLoginOptions() vault.IAMLoginOptions {
 	}
 }
 
-// getAppRoleLoginOptions creates an AppRoleLoginOptions object with values from the Component config
-func (c *Config) getAppRoleLoginOptions() vault.AppRoleLoginOptions {
+// getAppRoleLoginOptions creates an AppRoleLoginOptions object with values from the Component config, resolving the
+// Secret ID from whichever of AppRoleSecretID, AppRoleSecretIDFile, or AppRoleSecretIDEnv is set at call time so that
+// short-lived response-wrapped secret IDs are read as late as possible
+func (c *Config) getAppRoleLoginOptions(client *vault.Client) (vault.AppRoleLoginOptions, error) {
+	secretID, err := c.resolveAppRoleSecretID(client)
+	if err != nil {
+		return vault.AppRoleLoginOptions{}, err
+	}
+
 	return vault.AppRoleLoginOptions{
 		RoleID:    c.AppRoleID,
-		SecretID:  c.AppRoleSecretID,
+		SecretID:  secretID,
 		MountPath: c.AppRoleLoginPath,
+	}, nil
+}
+
+// resolveAppRoleSecretID reads the AppRole Secret ID from whichever source is configured, unwrapping it via client
+// if IsWrappingToken is set
+func (c *Config) resolveAppRoleSecretID(client *vault.Client) (string, error) {
+	var secretID string
+	switch {
+	case c.AppRoleSecretIDFile != emptyStr:
+		data, err := os.ReadFile(c.AppRoleSecretIDFile)
+		if err != nil {
+			return emptyStr, fmt.Errorf("failed to read AppRole Secret ID from %s: %w", c.AppRoleSecretIDFile, err)
+		}
+		secretID = strings.TrimSpace(string(data))
+	case c.AppRoleSecretIDEnv != emptyStr:
+		secretID = os.Getenv(c.AppRoleSecretIDEnv)
+	default:
+		secretID = c.AppRoleSecretID
+	}
+
+	if !c.IsWrappingToken {
+		return secretID, nil
+	}
+
+	unwrapped, err := client.Sys.Unwrap(secretID)
+	if err != nil {
+		return emptyStr, fmt.Errorf("failed to unwrap AppRole Secret ID response-wrapping token: %w", err)
 	}
+
+	secretIDData, ok := unwrapped.Data["secret_id"].(string)
+	if !ok {
+		return emptyStr, fmt.Errorf("failed to unwrap AppRole Secret ID response-wrapping token: unexpected response shape")
+	}
+
+	return secretIDData, nil
+}
+
+// getKubernetesLoginOptions creates a KubernetesLoginOptions object with values from the Component config, reading
+// the pod's ServiceAccount JWT from KubernetesJWTPath
+func (c *Config) getKubernetesLoginOptions() (vault.KubernetesLoginOptions, error) {
+	jwt, err := os.ReadFile(c.KubernetesJWTPath)
+	if err != nil {
+		return vault.KubernetesLoginOptions{}, fmt.Errorf("failed to read Kubernetes service account token from %s: %w", c.KubernetesJWTPath, err)
+	}
+
+	return vault.KubernetesLoginOptions{
+		Role:      c.KubernetesRole,
+		JWT:       string(jwt),
+		MountPath: c.KubernetesLoginPath,
+	}, nil
 }
 
 // Validate checks the Config to confirm that all required values are initialized
@@ -91,5 +201,15 @@ func (c *Config) Validate() error {
 		return fmt.Errorf(errFmtConfigValidation, propertySecretPath)
 	}
 
+	secretIDSources := 0
+	for _, source := range []string{c.AppRoleSecretID, c.AppRoleSecretIDFile, c.AppRoleSecretIDEnv} {
+		if source != emptyStr {
+			secretIDSources++
+		}
+	}
+	if secretIDSources > 1 {
+		return fmt.Errorf(errFmtAppRoleSecretIDConflict, envAppRoleSecretID, envAppRoleSecretIDFile, envAppRoleSecretIDEnv)
+	}
+
 	return nil
 }
@@ -0,0 +1,11 @@
+package vault
+
+import "errors"
+
+// Errors returned by Component's secret accessors in place of panicking on a failed type assertion
+var (
+	// ErrKeyNotFound is returned when the requested key is absent from the secret's data
+	ErrKeyNotFound = errors.New("vault: key not found in secret")
+	// ErrSecretShape is returned when the secret response does not have the shape the Component expects
+	ErrSecretShape = errors.New("vault: secret response had unexpected shape")
+)
@@ -1,7 +1,9 @@
 package vault
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/riotgames/vault-go-client"
 	"go.uber.org/zap"
@@ -13,16 +15,25 @@ type Option func(*options)
 // AuthProvider is a function that accepts a Vault client and authenticates the client with the Vault server
 type AuthProvider func(client *vault.Client) error
 
+// RenewalErrorHandler is a callback invoked with the error that ended a token renewal cycle (token expired, hit its
+// max TTL, or renewal was not permitted) before Component re-authenticates
+type RenewalErrorHandler func(err error)
+
 type options struct {
-	log          *zap.Logger
-	url          string
-	authProvider AuthProvider
+	log            *zap.Logger
+	url            string
+	authProvider   AuthProvider
+	renewIncrement int
+	onRenewalError RenewalErrorHandler
+	cacheTTL       time.Duration
 }
 
 func createDefaultOptions(config *Config) *options {
 	opts := &options{
-		log: zap.L(),
-		url: defaultURL,
+		log:            zap.L(),
+		url:            defaultURL,
+		renewIncrement: defaultRenewIncrement,
+		cacheTTL:       defaultCacheTTL,
 	}
 	WithDefaultChainAuthProvider(config)(opts)
 	return opts
@@ -42,6 +53,30 @@ func WithURL(url string) Option {
 	}
 }
 
+// WithRenewalIncrement sets the renewal increment, in seconds, requested on each background token renewal started by
+// Component.Start. The Vault server treats this as a hint and may return a shorter lease.
+func WithRenewalIncrement(seconds int) Option {
+	return func(o *options) {
+		o.renewIncrement = seconds
+	}
+}
+
+// WithRenewalErrorHandler sets a callback invoked whenever background token renewal ends and Component falls back to
+// re-authenticating with the configured AuthProvider
+func WithRenewalErrorHandler(handler RenewalErrorHandler) Option {
+	return func(o *options) {
+		o.onRenewalError = handler
+	}
+}
+
+// WithCacheTTL sets how long Component caches a secret's data and metadata, per (mount path, secret path, version),
+// before re-fetching it from Vault on the next GetSecret/GetSecretVersion/GetSecrets call
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.cacheTTL = ttl
+	}
+}
+
 // WithDefaultChainAuthProvider attempts to authenticate to Vault with the default auth chain. This method is preferred to
 // authenticate the Vault Client both when running within AWS resources. and during local testing
 //
@@ -49,10 +84,12 @@ func WithURL(url string) Option {
 //   - vault.iamRoleARN
 //   - vault.appRoleID
 //   - vault.appRoleSecretID
+//   - vault.kubernetesRole
 //
 // These authentication methods are attempted in the following order:
 //   - IAM Login
 //   - AppRole Login
+//   - Kubernetes Login
 //
 // LDAP authentication is intentionally omitted to mitigate risk for exposing LDAP credentials during local testing.
 func WithDefaultChainAuthProvider(config *Config) Option {
@@ -68,15 +105,36 @@ func WithDefaultChainAuthProvider(config *Config) Option {
 			o.log.Debug(fmt.Sprintf("Vault Client IAM auth failed with err: %s", iamErr.Error()))
 			errs = append(errs, iamErr)
 
-			_, appRoleErr := client.Auth.AppRole.Login(config.getAppRoleLoginOptions())
-			if appRoleErr == nil {
-				o.log.Info("Vault Client authed with AppRole method")
+			appRoleLoginOptions, appRoleOptErr := config.getAppRoleLoginOptions(client)
+			if appRoleOptErr != nil {
+				o.log.Debug(fmt.Sprintf("Vault Client AppRole auth failed with err: %s", appRoleOptErr.Error()))
+				errs = append(errs, appRoleOptErr)
+			} else {
+				_, appRoleErr := client.Auth.AppRole.Login(appRoleLoginOptions)
+				if appRoleErr == nil {
+					o.log.Info("Vault Client authed with AppRole method")
+					return nil
+				}
+				o.log.Debug(fmt.Sprintf("Vault Client AppRole auth failed with err: %s", appRoleErr.Error()))
+				errs = append(errs, appRoleErr)
+			}
+
+			k8sLoginOptions, k8sOptErr := config.getKubernetesLoginOptions()
+			if k8sOptErr != nil {
+				o.log.Debug(fmt.Sprintf("Vault Client Kubernetes auth failed with err: %s", k8sOptErr.Error()))
+				errs = append(errs, k8sOptErr)
+				return errors.Join(errs...)
+			}
+
+			_, k8sErr := client.Auth.Kubernetes.Login(k8sLoginOptions)
+			if k8sErr == nil {
+				o.log.Info("Vault Client authed with Kubernetes method")
 				return nil
 			}
-			o.log.Debug(fmt.Sprintf("Vault Client AppRole auth failed with err: %s", appRoleErr.Error()))
-			errs = append(errs, appRoleErr)
+			o.log.Debug(fmt.Sprintf("Vault Client Kubernetes auth failed with err: %s", k8sErr.Error()))
+			errs = append(errs, k8sErr)
 
-			return fmt.Errorf("%w; %w", iamErr, appRoleErr)
+			return errors.Join(errs...)
 		}
 	}
 }
@@ -102,3 +160,20 @@ func WithAppRoleAuthProvider(loginOptions vault.AppRoleLoginOptions) Option {
 		}
 	}
 }
+
+// WithKubernetesAuthProvider attempts to authenticate to Vault with the Kubernetes login method, reading the pod's
+// ServiceAccount JWT from config.KubernetesJWTPath on every login attempt:
+// https://developer.hashicorp.com/vault/docs/auth/kubernetes
+func WithKubernetesAuthProvider(config *Config) Option {
+	return func(o *options) {
+		o.authProvider = func(client *vault.Client) error {
+			loginOptions, optErr := config.getKubernetesLoginOptions()
+			if optErr != nil {
+				return optErr
+			}
+
+			_, authErr := client.Auth.Kubernetes.Login(loginOptions)
+			return authErr
+		}
+	}
+}